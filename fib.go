@@ -3,6 +3,12 @@
 // correct, barring overflow issues, only differing in execution time.
 package fib
 
+import (
+	"context"
+	"math"
+	"math/big"
+)
+
 // Basic recursive algorithm, with exponential run time.  Simply makes two extra
 // calls for level. In testing benchmarks, we do not go above 32 for this
 // function due to the extreme length of time it takes to complete, it is not
@@ -63,28 +69,239 @@ func FibIterative(n int) int {
 	return second
 }
 
-// func FibPowerMatrix(n int) int {
-// 	F := [][]int{
-// 		[]int{1, 1},
-// 		[]int{1, 0},
-// 	}
-// 	if n == 0 {
-// 		return 0
-// 	}
-// 	fibPower(F, n-1)
-// 	return F[0][0]
-// }
-
-// func fibPower(F [2][2]int, n int) {
-// 	M := [][]int{
-// 		[]int{1, 1},
-// 		[]int{1, 0},
-// 	}
-// 	for i := 2; i <= n; i++ {
-// 		fibMultiply(F, M)
-// 	}
-// }
-
-// func fibMultiply(F [2][2]int, M [2][2]int) {
-
-// }
+// fib2x2 is a 2x2 matrix of big.Int, used to represent powers of
+// [[1,1],[1,0]] for FibPowerMatrix.
+type fib2x2 [2][2]*big.Int
+
+// FibPowerMatrix computes F(n) by raising [[1,1],[1,0]] to the (n-1)th power
+// via repeated squaring, giving O(log n) big.Int multiplications rather than
+// the O(n) additions used by FibIterative.
+func FibPowerMatrix(n int) *big.Int {
+	if n == 0 {
+		return big.NewInt(0)
+	}
+	F := fib2x2{
+		{big.NewInt(1), big.NewInt(1)},
+		{big.NewInt(1), big.NewInt(0)},
+	}
+	return fibPower(F, n-1)[0][0]
+}
+
+// fibPower raises M to the nth power by repeated squaring.
+func fibPower(M fib2x2, n int) fib2x2 {
+	result := fib2x2{
+		{big.NewInt(1), big.NewInt(0)},
+		{big.NewInt(0), big.NewInt(1)},
+	}
+	for n > 0 {
+		if n&1 == 1 {
+			result = fibMultiply(result, M)
+		}
+		M = fibMultiply(M, M)
+		n >>= 1
+	}
+	return result
+}
+
+// fibMultiply multiplies two 2x2 big.Int matrices.
+func fibMultiply(A, B fib2x2) fib2x2 {
+	mul := func(a, b *big.Int) *big.Int { return new(big.Int).Mul(a, b) }
+	return fib2x2{
+		{
+			new(big.Int).Add(mul(A[0][0], B[0][0]), mul(A[0][1], B[1][0])),
+			new(big.Int).Add(mul(A[0][0], B[0][1]), mul(A[0][1], B[1][1])),
+		},
+		{
+			new(big.Int).Add(mul(A[1][0], B[0][0]), mul(A[1][1], B[1][0])),
+			new(big.Int).Add(mul(A[1][0], B[0][1]), mul(A[1][1], B[1][1])),
+		},
+	}
+}
+
+// FibFastDoubling computes F(n) in O(log n) big.Int multiplications using the
+// doubling identities F(2k) = F(k)*(2*F(k+1) - F(k)) and
+// F(2k+1) = F(k)^2 + F(k+1)^2, recursing on the bits of n.
+func FibFastDoubling(n int) *big.Int {
+	fk, _ := fibDoubling(n)
+	return fk
+}
+
+// fibDoubling returns the pair (F(k), F(k+1)).
+func fibDoubling(k int) (*big.Int, *big.Int) {
+	if k == 0 {
+		return big.NewInt(0), big.NewInt(1)
+	}
+	a, b := fibDoubling(k / 2)
+
+	// With m = k/2, a = F(m) and b = F(m+1).
+	// c = F(2m) = a*(2*b - a)
+	c := new(big.Int).Sub(new(big.Int).Mul(big.NewInt(2), b), a)
+	c.Mul(c, a)
+	// d = F(2m+1) = a^2 + b^2
+	d := new(big.Int).Add(new(big.Int).Mul(a, a), new(big.Int).Mul(b, b))
+
+	if k%2 == 0 {
+		return c, d
+	}
+	return d, new(big.Int).Add(c, d)
+}
+
+// FibBig is the arbitrary-precision counterpart to FibIterative. The fixed-width
+// implementations above overflow int64 around F(93), so anything beyond that
+// range needs to run through math/big instead. Unlike FibIterative, which
+// returns 1 at n=0 because its loop never runs, FibBig(0) returns the
+// mathematically correct 0 — all of the big.Int variants in this file agree
+// on n=0, deliberately breaking with FibIterative's off-by-one there.
+func FibBig(n int) *big.Int {
+	if n == 0 {
+		return big.NewInt(0)
+	}
+	temp := new(big.Int)
+	first := big.NewInt(0)
+	second := big.NewInt(1)
+	for i := 0; i < n-1; i++ {
+		temp.Set(second)
+		second.Add(first, second)
+		first.Set(temp)
+	}
+	return second
+}
+
+// FibTailRecursiveBig is the big.Int counterpart to FibTailRecursive.
+func FibTailRecursiveBig(n int) *big.Int {
+	return fibTailRecursiveBig(n, big.NewInt(0), big.NewInt(1))
+}
+
+func fibTailRecursiveBig(n int, first, second *big.Int) *big.Int {
+	if n == 0 {
+		return first
+	}
+	return fibTailRecursiveBig(n-1, second, new(big.Int).Add(first, second))
+}
+
+// FibRecursiveCacheBig is the big.Int counterpart to FibRecursiveCache. It is
+// special-cased at n=0: the shared recursive helper seeds both cache[0] and
+// cache[1], which would index out of range against a length-1 cache, and
+// returning 0 here also keeps n=0 consistent with the rest of the big.Int
+// variants (see FibBig).
+func FibRecursiveCacheBig(n int) *big.Int {
+	if n == 0 {
+		return big.NewInt(0)
+	}
+	cache := make([]*big.Int, n+1, n+1)
+	fibRecursiveCacheBig(n, &cache)
+	return cache[n]
+}
+
+func fibRecursiveCacheBig(n int, cache *[]*big.Int) {
+	if n < 2 {
+		(*cache)[0] = big.NewInt(0)
+		(*cache)[1] = big.NewInt(1)
+		return
+	}
+	fibRecursiveCacheBig(n-1, cache)
+
+	(*cache)[n] = new(big.Int).Add((*cache)[n-1], (*cache)[n-2])
+}
+
+// fibBinetConstantsFloat holds the golden ratio and its conjugate used by the
+// closed-form Binet formula: F(n) = (phi^n - psi^n) / sqrt(5).
+const (
+	fibPhi float64 = 1.618033988749895  // (1 + sqrt(5)) / 2
+	fibPsi float64 = -0.618033988749895 // (1 - sqrt(5)) / 2
+)
+
+// FibBinet computes F(n) via the closed-form Binet formula using math.Pow and
+// float64 arithmetic, rounding to the nearest integer. float64 precision is
+// only enough to recover the exact integer answer up to n=75; F(76) is the
+// first value where rounding diverges from the true result, so callers
+// needing n beyond that range should use FibBinetBig instead.
+func FibBinet(n int) int {
+	sqrt5 := math.Sqrt(5)
+	val := (math.Pow(fibPhi, float64(n)) - math.Pow(fibPsi, float64(n))) / sqrt5
+	return int(math.Round(val))
+}
+
+// FibBinetBig computes F(n) via the Binet formula using math/big.Float at
+// the given precision (in bits), so the result stays exact for n far beyond
+// where FibBinet's float64 arithmetic diverges.
+func FibBinetBig(n int, prec uint) *big.Int {
+	sqrt5 := new(big.Float).SetPrec(prec).Sqrt(big.NewFloat(5))
+
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+	phi := new(big.Float).SetPrec(prec).Add(one, sqrt5)
+	phi.Quo(phi, big.NewFloat(2))
+
+	psi := new(big.Float).SetPrec(prec).Sub(one, sqrt5)
+	psi.Quo(psi, big.NewFloat(2))
+
+	phiN := fibBigFloatPow(phi, n, prec)
+	psiN := fibBigFloatPow(psi, n, prec)
+
+	num := new(big.Float).SetPrec(prec).Sub(phiN, psiN)
+	result := new(big.Float).SetPrec(prec).Quo(num, sqrt5)
+
+	// big.Float.Int truncates, so nudge by half before truncating to get
+	// round-to-nearest instead (the true value is always non-negative here).
+	half := new(big.Float).SetPrec(prec).SetFloat64(0.5)
+	result.Add(result, half)
+
+	rounded, _ := result.Int(nil)
+	return rounded
+}
+
+// fibBigFloatPow raises base to the nth power by repeated squaring.
+func fibBigFloatPow(base *big.Float, n int, prec uint) *big.Float {
+	result := new(big.Float).SetPrec(prec).SetInt64(1)
+	b := new(big.Float).SetPrec(prec).Copy(base)
+	for n > 0 {
+		if n&1 == 1 {
+			result.Mul(result, b)
+		}
+		b.Mul(b, b)
+		n >>= 1
+	}
+	return result
+}
+
+// FibSequence returns the first n Fibonacci numbers, F(0) through F(n-1), in
+// a single pass. Unlike calling FibIterative repeatedly, this avoids
+// recomputing the series from scratch for each term. Note that seq[0] is the
+// mathematically correct F(0)=0, whereas FibIterative(0) returns 1 because
+// its loop never executes — FibIterative is not a valid oracle for index 0.
+func FibSequence(n int) []int {
+	seq := make([]int, n)
+	if n == 0 {
+		return seq
+	}
+	first, second := 0, 1
+	seq[0] = first
+	for i := 1; i < n; i++ {
+		seq[i] = second
+		first, second = second, first+second
+	}
+	return seq
+}
+
+// FibStream yields successive Fibonacci numbers on the returned channel,
+// starting at the mathematically correct F(0)=0, until ctx is cancelled. The
+// channel is closed once ctx is done, so callers should range over it rather
+// than checking ctx separately. Note that FibIterative(0) returns 1 rather
+// than 0, so it is not a valid oracle for the stream's first term.
+func FibStream(ctx context.Context) <-chan *big.Int {
+	out := make(chan *big.Int)
+	go func() {
+		defer close(out)
+		first := big.NewInt(0)
+		second := big.NewInt(1)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case out <- first:
+				first, second = second, new(big.Int).Add(first, second)
+			}
+		}
+	}()
+	return out
+}
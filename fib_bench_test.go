@@ -0,0 +1,76 @@
+package fib
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+)
+
+// fibImpls lists the scalar Fib(n) implementations that BenchmarkAll drives,
+// keyed by name. The Binet-formula implementations (FibBinet, FibBinetBig)
+// are not included. Implementations that return something other than a
+// single nth value (FibSequence, FibStream) aren't scalar point computations
+// and aren't included here either.
+var fibImpls = map[string]func(int){
+	"FibRecursive":         func(n int) { FibRecursive(n) },
+	"FibRecursiveCache":    func(n int) { FibRecursiveCache(n) },
+	"FibTailRecursive":     func(n int) { FibTailRecursive(n) },
+	"FibIterative":         func(n int) { FibIterative(n) },
+	"FibBig":               func(n int) { FibBig(n) },
+	"FibTailRecursiveBig":  func(n int) { FibTailRecursiveBig(n) },
+	"FibRecursiveCacheBig": func(n int) { FibRecursiveCacheBig(n) },
+	"FibPowerMatrix":       func(n int) { FibPowerMatrix(n) },
+	"FibFastDoubling":      func(n int) { FibFastDoubling(n) },
+}
+
+// fibImplNames fixes an order for fibImpls so that sub-benchmark output (and
+// the -tags report table) is reproducible across runs.
+var fibImplNames = []string{
+	"FibRecursive",
+	"FibRecursiveCache",
+	"FibTailRecursive",
+	"FibIterative",
+	"FibBig",
+	"FibTailRecursiveBig",
+	"FibRecursiveCacheBig",
+	"FibPowerMatrix",
+	"FibFastDoubling",
+}
+
+// benchmarkNs is the range of n used across the comparative harness.
+var benchmarkNs = []int{5, 10, 20, 30, 50, 100, 1000, 10000}
+
+// BenchmarkAll runs every implementation in fibImpls across benchmarkNs as
+// b.Run sub-benchmarks, reporting allocations for each. FibRecursive is
+// skipped above n=32, per the warning on its doc comment, since its
+// exponential run time makes larger n impractical to benchmark. When built
+// with -tags report, each sub-benchmark's result is also appended as a row
+// to bench_output.txt via reportBenchmark. Bytes/op is measured directly off
+// runtime.MemStats around the timed loop, rather than off *testing.B, which
+// has no public accessor for it.
+func BenchmarkAll(b *testing.B) {
+	for _, n := range benchmarkNs {
+		for _, name := range fibImplNames {
+			if name == "FibRecursive" && n > 32 {
+				continue
+			}
+			impl := fibImpls[name]
+			b.Run(fmt.Sprintf("%s/n=%d", name, n), func(b *testing.B) {
+				b.ReportAllocs()
+
+				var before, after runtime.MemStats
+				runtime.ReadMemStats(&before)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					impl(n)
+				}
+				b.StopTimer()
+				runtime.ReadMemStats(&after)
+
+				nsPerOp := b.Elapsed().Nanoseconds() / int64(b.N)
+				bytesPerOp := (after.TotalAlloc - before.TotalAlloc) / uint64(b.N)
+				reportBenchmark(name, n, nsPerOp, bytesPerOp)
+			})
+		}
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !report
+
+package fib
+
+// reportBenchmark is a no-op unless the binary is built with -tags report,
+// so the normal `go test -bench` path doesn't pay for file I/O it didn't ask
+// for. See fib_report_test.go for the report-writing implementation.
+func reportBenchmark(name string, n int, nsPerOp int64, bytesPerOp uint64) {}
@@ -0,0 +1,40 @@
+//go:build report
+
+package fib
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// reportFile is opened lazily on first use and left open for the duration of
+// the test binary; go test does not give benchmarks a shared teardown hook,
+// so the file is flushed on every write instead of closed at the end.
+var (
+	reportOnce sync.Once
+	reportFile *os.File
+	reportErr  error
+)
+
+func openReportFile() (*os.File, error) {
+	reportOnce.Do(func() {
+		reportFile, reportErr = os.Create("bench_output.txt")
+		if reportErr == nil {
+			fmt.Fprintf(reportFile, "%-24s%-10s%-16s%-10s\n", "name", "n", "ns/op", "B/op")
+		}
+	})
+	return reportFile, reportErr
+}
+
+// reportBenchmark appends a row of (name, n, ns/op, B/op) to bench_output.txt
+// so the comparative table from a -tags report run can be reproduced without
+// re-parsing `go test -bench` output.
+func reportBenchmark(name string, n int, nsPerOp int64, bytesPerOp uint64) {
+	f, err := openReportFile()
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(f, "%-24s%-10d%-16d%-10d\n", name, n, nsPerOp, bytesPerOp)
+	f.Sync()
+}
@@ -0,0 +1,234 @@
+package fib
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// bigFromString panics on malformed input, which is fine for test-table
+// literals where the value is known ahead of time.
+func bigFromString(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("fib: invalid big.Int literal: " + s)
+	}
+	return v
+}
+
+// largeFibTests holds known-correct Fibonacci values too large to fit in an
+// int64, used to guard the math/big implementations against regressions.
+var largeFibTests = []struct {
+	n    int
+	want *big.Int
+}{
+	{100, bigFromString("354224848179261915075")},
+	{500, bigFromString("139423224561697880139724382870407283950070256587697307264108962948325571622863290691557658876222521294125")},
+	{1000, bigFromString("43466557686937456435688527675040625802564660517371780402481729089536555417949051890403879840079255169295922593080322634775209689623239873322471161642996440906533187938298969649928516003704476137795166849228875")},
+}
+
+func TestFibBig(t *testing.T) {
+	for _, tt := range largeFibTests {
+		if got := FibBig(tt.n); got.Cmp(tt.want) != 0 {
+			t.Errorf("FibBig(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFibTailRecursiveBig(t *testing.T) {
+	for _, tt := range largeFibTests {
+		if got := FibTailRecursiveBig(tt.n); got.Cmp(tt.want) != 0 {
+			t.Errorf("FibTailRecursiveBig(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFibRecursiveCacheBig(t *testing.T) {
+	for _, tt := range largeFibTests {
+		if got := FibRecursiveCacheBig(tt.n); got.Cmp(tt.want) != 0 {
+			t.Errorf("FibRecursiveCacheBig(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFibPowerMatrix(t *testing.T) {
+	for _, tt := range largeFibTests {
+		if got := FibPowerMatrix(tt.n); got.Cmp(tt.want) != 0 {
+			t.Errorf("FibPowerMatrix(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFibFastDoubling(t *testing.T) {
+	for _, tt := range largeFibTests {
+		if got := FibFastDoubling(tt.n); got.Cmp(tt.want) != 0 {
+			t.Errorf("FibFastDoubling(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+// TestBigVariantsAgreeAtZero guards against the big.Int variants drifting
+// apart on F(0) the way FibBig and FibRecursiveCacheBig once silently
+// inherited FibIterative's off-by-one there while the others didn't.
+func TestBigVariantsAgreeAtZero(t *testing.T) {
+	zero := big.NewInt(0)
+	variants := map[string]*big.Int{
+		"FibBig":               FibBig(0),
+		"FibTailRecursiveBig":  FibTailRecursiveBig(0),
+		"FibRecursiveCacheBig": FibRecursiveCacheBig(0),
+		"FibPowerMatrix":       FibPowerMatrix(0),
+		"FibFastDoubling":      FibFastDoubling(0),
+	}
+	for name, got := range variants {
+		if got.Cmp(zero) != 0 {
+			t.Errorf("%s(0) = %s, want 0", name, got)
+		}
+	}
+}
+
+func TestFibSequence(t *testing.T) {
+	seq := FibSequence(20)
+	if seq[0] != 0 {
+		t.Errorf("FibSequence(20)[0] = %d, want 0", seq[0])
+	}
+	// FibIterative(0) returns 1, not the mathematical F(0)=0, so it is only
+	// a valid oracle for i>=1 here.
+	for i := 1; i < len(seq); i++ {
+		if want := FibIterative(i); seq[i] != want {
+			t.Errorf("FibSequence(20)[%d] = %d, want %d", i, seq[i], want)
+		}
+	}
+}
+
+func TestFibStreamMatchesIterative(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// FibIterative(0) returns 1, not the mathematical F(0)=0, and it overflows
+	// int64 around n=93 (see FibBig's doc comment), so it's only a valid
+	// oracle for 1 <= i < 93. FibBig has no such ceiling, so it stands in for
+	// the rest of the 1000 terms.
+	const fibIterativeSafeCeiling = 93
+
+	stream := FibStream(ctx)
+	i := 0
+	for got := range stream {
+		if i >= 1000 {
+			cancel()
+			break
+		}
+		var want *big.Int
+		switch {
+		case i == 0:
+			want = big.NewInt(0)
+		case i < fibIterativeSafeCeiling:
+			want = big.NewInt(int64(FibIterative(i)))
+		default:
+			want = FibBig(i)
+		}
+		if got.Cmp(want) != 0 {
+			t.Fatalf("FibStream term %d = %s, want %s", i, got, want)
+		}
+		i++
+	}
+}
+
+func TestFibStreamCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := FibStream(ctx)
+
+	<-stream
+	cancel()
+
+	select {
+	case _, ok := <-stream:
+		if ok {
+			// Drain any values already in flight before the cancellation
+			// was observed; the channel must still close promptly.
+			for range stream {
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("FibStream did not close promptly after cancellation")
+	}
+}
+
+func TestFibBinetSafeRange(t *testing.T) {
+	// float64 precision holds up through F(75); F(76) is documented as the
+	// first divergence on FibBinet's doc comment. FibBig is used as the
+	// oracle rather than FibIterative, since FibIterative(0) is off by one.
+	for n := 0; n <= 75; n++ {
+		got := big.NewInt(int64(FibBinet(n)))
+		if want := FibBig(n); got.Cmp(want) != 0 {
+			t.Errorf("FibBinet(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
+
+func TestFibBinetDivergesAt76(t *testing.T) {
+	got := big.NewInt(int64(FibBinet(76)))
+	if want := FibBig(76); got.Cmp(want) == 0 {
+		t.Errorf("FibBinet(76) = %s, expected it to diverge from the true value %s", got, want)
+	}
+}
+
+func TestFibBinetBig(t *testing.T) {
+	const prec = 4096
+	for n := 0; n <= 500; n++ {
+		got := FibBinetBig(n, prec)
+		want := FibBig(n)
+		if got.Cmp(want) != 0 {
+			t.Errorf("FibBinetBig(%d, %d) = %s, want %s", n, prec, got, want)
+		}
+	}
+}
+
+func BenchmarkFibIterative_1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibIterative(1000)
+	}
+}
+
+func BenchmarkFibBig_1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibBig(1000)
+	}
+}
+
+func BenchmarkFibTailRecursiveBig_1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibTailRecursiveBig(1000)
+	}
+}
+
+func BenchmarkFibRecursiveCacheBig_1000(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FibRecursiveCacheBig(1000)
+	}
+}
+
+// BenchmarkFibCrossover compares the linear big.Int implementation against
+// the O(log n) fast-doubling and matrix-exponentiation implementations
+// across a range of n, to show where the log-n methods start to win.
+func BenchmarkFibCrossover(b *testing.B) {
+	ns := []int{5, 10, 20, 30, 50, 100, 1000, 10000}
+	for _, n := range ns {
+		b.Run(fmt.Sprintf("Linear/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				FibBig(n)
+			}
+		})
+		b.Run(fmt.Sprintf("FastDoubling/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				FibFastDoubling(n)
+			}
+		})
+		b.Run(fmt.Sprintf("PowerMatrix/n=%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				FibPowerMatrix(n)
+			}
+		})
+	}
+}